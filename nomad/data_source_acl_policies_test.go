@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"testing"
+
+	"github.com/hashicorp/nomad/api"
+)
+
+func TestACLPolicyMatchesWorkloadIdentity(t *testing.T) {
+	jobACL := &api.JobACL{
+		Namespace: "default",
+		JobID:     "web",
+		Group:     "api",
+		Task:      "server",
+	}
+
+	cases := []struct {
+		name                          string
+		jobACL                        *api.JobACL
+		namespace, jobID, group, task string
+		want                          bool
+	}{
+		{"no filters matches nil job_acl", nil, "", "", "", "", true},
+		{"no filters matches any job_acl", jobACL, "", "", "", "", true},
+		{"filter requires job_acl", nil, "default", "", "", "", false},
+		{"matching namespace", jobACL, "default", "", "", "", true},
+		{"mismatched namespace", jobACL, "other", "", "", "", false},
+		{"matching job_id", jobACL, "", "web", "", "", true},
+		{"mismatched job_id", jobACL, "", "other", "", "", false},
+		{"matching group", jobACL, "", "", "api", "", true},
+		{"mismatched group", jobACL, "", "", "other", "", false},
+		{"matching task", jobACL, "", "", "", "server", true},
+		{"mismatched task", jobACL, "", "", "", "other", false},
+		{"all match", jobACL, "default", "web", "api", "server", true},
+		{"one mismatch among many filters", jobACL, "default", "web", "api", "other", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := aclPolicyMatchesWorkloadIdentity(c.jobACL, c.namespace, c.jobID, c.group, c.task)
+			if got != c.want {
+				t.Fatalf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceACLPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceACLPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Unique name for this policy.",
+				Required:    true,
+				Type:        schema.TypeString,
+			},
+
+			"description": {
+				Description: "Description for this policy.",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+
+			"rules_hcl": {
+				Description: "HCL or JSON representation of the rules to enforce on this policy.",
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
+
+			"job_acl": {
+				Description: "Workload identity association applied to the policy.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespace": {
+							Description: "Namespace",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"job_id": {
+							Description: "Job",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"group": {
+							Description: "Group",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"task": {
+							Description: "Task",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceACLPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	providerConfig := meta.(ProviderConfig)
+	client := providerConfig.client
+
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Reading ACL policy %q", name)
+	policy, _, err := client.ACLPolicies().Info(name, nil)
+	if err != nil {
+		return fmt.Errorf("error reading ACLPolicy %q: %s", name, err.Error())
+	}
+	log.Printf("[DEBUG] Read ACL policy %q", name)
+
+	d.SetId(policy.Name)
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("rules_hcl", policy.Rules)
+	d.Set("job_acl", flattenACLPolicyJobACL(policy.JobACL))
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ProviderConfig holds the configured Nomad API client shared by every
+// resource and data source in this provider.
+type ProviderConfig struct {
+	client *api.Client
+}
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NOMAD_ADDR", "http://127.0.0.1:4646"),
+				Description: "URL of the root of the target Nomad agent.",
+			},
+
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NOMAD_REGION", ""),
+				Description: "Region of the target Nomad agent.",
+			},
+
+			"secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NOMAD_TOKEN", ""),
+				Description: "ACL token secret for API requests.",
+			},
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"nomad_acl_policy":            resourceACLPolicy(),
+			"nomad_acl_policy_attachment": resourceACLPolicyAttachment(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"nomad_acl_policy":   dataSourceACLPolicy(),
+			"nomad_acl_policies": dataSourceACLPolicies(),
+		},
+
+		ConfigureFunc: providerConfigure,
+	}
+}
+
+func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	config := api.DefaultConfig()
+
+	if address, ok := d.GetOk("address"); ok {
+		config.Address = address.(string)
+	}
+
+	if region, ok := d.GetOk("region"); ok {
+		config.Region = region.(string)
+	}
+
+	if secretID, ok := d.GetOk("secret_id"); ok {
+		config.SecretID = secretID.(string)
+	}
+
+	client, err := api.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing Nomad client: %s", err)
+	}
+
+	return ProviderConfig{client: client}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/nomad/acl"
 	"github.com/hashicorp/nomad/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -25,6 +26,8 @@ func resourceACLPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceACLPolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "Unique name for this policy.",
@@ -45,33 +48,44 @@ func resourceACLPolicy() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 
+			"validate": {
+				Description: "Parse rules_hcl locally and fail the plan on invalid policy syntax, rather than waiting for Nomad to reject it at apply time. Disable this if rules_hcl targets rule syntax newer than what this provider's vendored parser understands.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+				Default:     true,
+			},
+
 			"job_acl": {
-				Description: "Workload identity association that should be applied to the policy.",
+				Description: "Workload identity association that should be applied to the policy. A policy holds at most one workload identity. To manage that attachment separately from the policy definition, use the `nomad_acl_policy_attachment` resource instead and leave this block unset; that resource also attaches only one workload identity per policy.",
 				Optional:    true,
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"namespace": {
-							Description: "Namespace",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "default",
+							Description:  "Namespace",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "default",
+							ValidateFunc: validateACLJobACLIdentifier(false),
 						},
 						"job_id": {
-							Description: "Job",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:  "Job",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateACLJobACLIdentifier(true),
 						},
 						"group": {
-							Description: "Group",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:  "Group",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateACLJobACLIdentifier(false),
 						},
 						"task": {
-							Description: "Task",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:  "Task",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateACLJobACLIdentifier(false),
 						},
 					},
 				},
@@ -80,6 +94,68 @@ func resourceACLPolicy() *schema.Resource {
 	}
 }
 
+// resourceACLPolicyCustomizeDiff parses rules_hcl with Nomad's own ACL policy
+// parser so that malformed rules fail `terraform plan` with line/column
+// information instead of surfacing as a generic 400 from the server at apply
+// time. It also enforces the job_acl hierarchy invariants at plan time,
+// rather than only once parseWorkloadIdentity runs at apply time.
+func resourceACLPolicyCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("validate").(bool) {
+		if rules := diff.Get("rules_hcl").(string); rules != "" {
+			if _, err := acl.Parse(rules); err != nil {
+				return fmt.Errorf("rules_hcl is invalid: %s", err.Error())
+			}
+		}
+	}
+
+	if diff.Get("job_acl.#").(int) == 1 {
+		namespace := diff.Get("job_acl.0.namespace").(string)
+		jobID := diff.Get("job_acl.0.job_id").(string)
+		group := diff.Get("job_acl.0.group").(string)
+		task := diff.Get("job_acl.0.task").(string)
+
+		if err := validateJobACLHierarchy(namespace, jobID, group, task); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateACLJobACLIdentifier checks a job_acl field against Nomad's
+// identifier rules: non-empty fields must not contain path separators and
+// must fit within Nomad's identifier length limit. When required is true, an
+// explicitly empty value is also rejected instead of being treated the same
+// as "not set" - job_id scopes the workload identity claim that ACL
+// enforcement depends on, so silently letting it through empty is a
+// footgun, not a default.
+func validateACLJobACLIdentifier(required bool) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return warnings, errors
+		}
+
+		if v == "" {
+			if required {
+				errors = append(errors, fmt.Errorf("%q must not be empty", k))
+			}
+			return warnings, errors
+		}
+
+		if len(v) > 128 {
+			errors = append(errors, fmt.Errorf("%q must be 128 characters or fewer, got %d", k, len(v)))
+		}
+
+		if strings.ContainsAny(v, "/\\") {
+			errors = append(errors, fmt.Errorf("%q must not contain path separators, got: %q", k, v))
+		}
+
+		return warnings, errors
+	}
+}
+
 func parseWorkloadIdentity(workloadIdentity interface{}) (*api.JobACL, error) {
 	jobACLs, ok := workloadIdentity.([]interface{})
 	if !ok || len(jobACLs) > 1 {
@@ -92,7 +168,7 @@ func parseWorkloadIdentity(workloadIdentity interface{}) (*api.JobACL, error) {
 	}
 
 	var namespace, jobID, group, task string
-	if val, ok := jobACL["namepace"].(string); ok {
+	if val, ok := jobACL["namespace"].(string); ok {
 		namespace = val
 	}
 
@@ -112,26 +188,56 @@ func parseWorkloadIdentity(workloadIdentity interface{}) (*api.JobACL, error) {
 		task = val
 	}
 
+	if err := validateJobACLHierarchy(namespace, jobID, group, task); err != nil {
+		return nil, err
+	}
+
+	result := &api.JobACL{
+		Namespace: namespace,
+		JobID:     jobID,
+		Group:     group,
+		Task:      task,
+	}
+
+	return result, nil
+}
+
+// validateJobACLHierarchy enforces that the workload identity fields of a
+// JobACL are only ever set in a way that Nomad itself considers valid:
+// a group can't be scoped without a job, and a task can't be scoped
+// without a group.
+func validateJobACLHierarchy(namespace, jobID, group, task string) error {
 	if jobID != "" && namespace == "" {
-		return nil, errors.New("namespace must be set to set job ID")
+		return errors.New("namespace must be set to set job ID")
 	}
 
 	if group != "" && jobID == "" {
-		return nil, errors.New("job ID must be set to set group")
+		return errors.New("job ID must be set to set group")
 	}
 
 	if task != "" && group == "" {
-		return nil, errors.New("group must be set to set task")
+		return errors.New("group must be set to set task")
 	}
 
-	result := &api.JobACL{
-		Namespace: namespace,
-		JobID:     jobID,
-		Group:     group,
-		Task:      task,
+	return nil
+}
+
+// preserveExternallyManagedJobACL is called when a nomad_acl_policy's
+// config has no inline job_acl block. A policy can only hold a single
+// JobACL, and that slot may be owned by a nomad_acl_policy_attachment
+// instead of this resource's config, so we must read the policy's current
+// JobACL back from Nomad and carry it forward rather than clobbering it
+// with the zero value on every apply.
+func preserveExternallyManagedJobACL(client *api.Client, name string) (*api.JobACL, error) {
+	existing, _, err := client.ACLPolicies().Info(name, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading ACLPolicy %q: %s", name, err.Error())
 	}
 
-	return result, nil
+	return existing.JobACL, nil
 }
 
 func resourceACLPolicyCreate(d *schema.ResourceData, meta interface{}) error {
@@ -151,6 +257,11 @@ func resourceACLPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			policy.JobACL = parsedPolicy
 		}
+	} else {
+		var err error
+		if policy.JobACL, err = preserveExternallyManagedJobACL(client, policy.Name); err != nil {
+			return err
+		}
 	}
 
 	// upsert our policy
@@ -181,6 +292,11 @@ func resourceACLPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 		if policy.JobACL, err = parseWorkloadIdentity(jobPolicy); err != nil {
 			return err
 		}
+	} else {
+		var err error
+		if policy.JobACL, err = preserveExternallyManagedJobACL(client, policy.Name); err != nil {
+			return err
+		}
 	}
 
 	// upsert our policy
@@ -228,18 +344,36 @@ func resourceACLPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("description", policy.Description)
 	d.Set("rules_hcl", policy.Rules)
 
-	if policy.JobACL != nil {
-		d.Set("job_acl", []map[string]string{{
-			"namespace": policy.JobACL.Namespace,
-			"job_id":    policy.JobACL.JobID,
-			"group":     policy.JobACL.Group,
-			"task":      policy.JobACL.Task,
-		}})
+	// job_acl isn't Computed: an absent block means this resource's config
+	// doesn't manage a workload identity, which is also true when the slot
+	// is owned by a nomad_acl_policy_attachment instead. Only refresh
+	// job_acl from the live policy when prior state already had a block,
+	// i.e. this resource is the one managing it; otherwise leave it unset
+	// so an externally-attached JobACL doesn't get pulled into state out
+	// from under an empty config, which would never converge.
+	if _, ok := d.GetOk("job_acl"); ok {
+		d.Set("job_acl", flattenACLPolicyJobACL(policy.JobACL))
 	}
 
 	return nil
 }
 
+// flattenACLPolicyJobACL converts a JobACL into the []map[string]string
+// shape expected by the job_acl schema block, returning nil when the policy
+// has no workload identity attached.
+func flattenACLPolicyJobACL(jobACL *api.JobACL) []map[string]string {
+	if jobACL == nil {
+		return nil
+	}
+
+	return []map[string]string{{
+		"namespace": jobACL.Namespace,
+		"job_id":    jobACL.JobID,
+		"group":     jobACL.Group,
+		"task":      jobACL.Task,
+	}}
+}
+
 func resourceACLPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	providerConfig := meta.(ProviderConfig)
 	client := providerConfig.client
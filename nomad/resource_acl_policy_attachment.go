@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/nomad/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceACLPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceACLPolicyAttachmentCreate,
+		Update: resourceACLPolicyAttachmentUpdate,
+		Delete: resourceACLPolicyAttachmentDelete,
+		Read:   resourceACLPolicyAttachmentRead,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceACLPolicyAttachmentCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"policy_name": {
+				Description: "The name of the nomad_acl_policy to attach the workload identity to. A policy can only hold one job_acl, so only one nomad_acl_policy_attachment (and no inline job_acl block) should target a given policy_name at a time. Planning a new attachment fails if the policy already has a job_acl; attachments to the same policy that are created in the same apply are not detected, and the last one applied wins.",
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+			},
+
+			"namespace": {
+				Description:  "Namespace",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ValidateFunc: validateACLJobACLIdentifier(false),
+			},
+
+			"job_id": {
+				Description:  "Job",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateACLJobACLIdentifier(true),
+			},
+
+			"group": {
+				Description:  "Group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateACLJobACLIdentifier(false),
+			},
+
+			"task": {
+				Description:  "Task",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateACLJobACLIdentifier(false),
+			},
+		},
+	}
+}
+
+// resourceACLPolicyAttachmentCustomizeDiff enforces the job_acl hierarchy
+// invariants at plan time, mirroring resourceACLPolicyCustomizeDiff. For a
+// brand new attachment it also guards against the policy already having a
+// job_acl attached, whether inline on nomad_acl_policy or via another
+// nomad_acl_policy_attachment, since a policy has room for only one.
+func resourceACLPolicyAttachmentCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	namespace := diff.Get("namespace").(string)
+	jobID := diff.Get("job_id").(string)
+	group := diff.Get("group").(string)
+	task := diff.Get("task").(string)
+
+	if err := validateJobACLHierarchy(namespace, jobID, group, task); err != nil {
+		return err
+	}
+
+	// Updates to an attachment this resource already owns are expected to
+	// see that same attachment reflected back on the policy; only guard
+	// new attachments, where any existing job_acl necessarily belongs to
+	// something else.
+	if diff.Id() != "" {
+		return nil
+	}
+
+	providerConfig, ok := meta.(ProviderConfig)
+	if !ok {
+		return nil
+	}
+	client := providerConfig.client
+
+	policyName := diff.Get("policy_name").(string)
+
+	policy, _, err := client.ACLPolicies().Info(policyName, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil
+		}
+		return fmt.Errorf("error reading ACLPolicy %q: %s", policyName, err.Error())
+	}
+
+	if policy.JobACL != nil {
+		return fmt.Errorf("ACL policy %q already has a job_acl attached (inline on nomad_acl_policy or via another nomad_acl_policy_attachment); only one workload identity can be attached to a policy at a time", policyName)
+	}
+
+	return nil
+}
+
+// resourceACLPolicyAttachmentID builds the composite ID used both to
+// identify the resource in state and to import it:
+// policy_name:namespace/job_id/group/task
+func resourceACLPolicyAttachmentID(policyName, namespace, jobID, group, task string) string {
+	return fmt.Sprintf("%s:%s/%s/%s/%s", policyName, namespace, jobID, group, task)
+}
+
+func parseACLPolicyAttachmentID(id string) (policyName, namespace, jobID, group, task string, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		err = fmt.Errorf("invalid ACL policy attachment ID %q, must be policy_name:namespace/job_id/group/task", id)
+		return
+	}
+	policyName = parts[0]
+
+	segments := strings.Split(parts[1], "/")
+	if len(segments) != 4 {
+		err = fmt.Errorf("invalid ACL policy attachment ID %q, must be policy_name:namespace/job_id/group/task", id)
+		return
+	}
+
+	namespace, jobID, group, task = segments[0], segments[1], segments[2], segments[3]
+	if namespace == "" {
+		namespace = "default"
+	}
+	if jobID == "" {
+		err = fmt.Errorf("invalid ACL policy attachment ID %q: job_id must be set", id)
+		return
+	}
+
+	return
+}
+
+func resourceACLPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConfig := meta.(ProviderConfig)
+	client := providerConfig.client
+
+	policyName := d.Get("policy_name").(string)
+	namespace := d.Get("namespace").(string)
+	jobID := d.Get("job_id").(string)
+	group := d.Get("group").(string)
+	task := d.Get("task").(string)
+
+	if err := validateJobACLHierarchy(namespace, jobID, group, task); err != nil {
+		return err
+	}
+
+	if err := attachACLPolicyJobACL(client, policyName, &api.JobACL{
+		Namespace: namespace,
+		JobID:     jobID,
+		Group:     group,
+		Task:      task,
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(resourceACLPolicyAttachmentID(policyName, namespace, jobID, group, task))
+
+	return resourceACLPolicyAttachmentRead(d, meta)
+}
+
+func resourceACLPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConfig := meta.(ProviderConfig)
+	client := providerConfig.client
+
+	policyName := d.Get("policy_name").(string)
+	namespace := d.Get("namespace").(string)
+	jobID := d.Get("job_id").(string)
+	group := d.Get("group").(string)
+	task := d.Get("task").(string)
+
+	if err := validateJobACLHierarchy(namespace, jobID, group, task); err != nil {
+		return err
+	}
+
+	if err := attachACLPolicyJobACL(client, policyName, &api.JobACL{
+		Namespace: namespace,
+		JobID:     jobID,
+		Group:     group,
+		Task:      task,
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(resourceACLPolicyAttachmentID(policyName, namespace, jobID, group, task))
+
+	return resourceACLPolicyAttachmentRead(d, meta)
+}
+
+// attachACLPolicyJobACL fetches the named policy and re-upserts it with the
+// given JobACL merged in, leaving the rest of the policy untouched.
+func attachACLPolicyJobACL(client *api.Client, policyName string, jobACL *api.JobACL) error {
+	log.Printf("[DEBUG] Reading ACL policy %q to attach workload identity", policyName)
+	policy, _, err := client.ACLPolicies().Info(policyName, nil)
+	if err != nil {
+		return fmt.Errorf("error reading ACLPolicy %q: %s", policyName, err.Error())
+	}
+
+	policy.JobACL = jobACL
+
+	log.Printf("[DEBUG] Attaching workload identity to ACL policy %q", policyName)
+	_, err = client.ACLPolicies().Upsert(policy, nil)
+	if err != nil {
+		return fmt.Errorf("error attaching workload identity to ACLPolicy %q: %s", policyName, err.Error())
+	}
+	log.Printf("[DEBUG] Attached workload identity to ACL policy %q", policyName)
+
+	return nil
+}
+
+func resourceACLPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConfig := meta.(ProviderConfig)
+	client := providerConfig.client
+
+	policyName := d.Get("policy_name").(string)
+	namespace := d.Get("namespace").(string)
+	jobID := d.Get("job_id").(string)
+	group := d.Get("group").(string)
+	task := d.Get("task").(string)
+
+	log.Printf("[DEBUG] Reading ACL policy %q to detach workload identity", policyName)
+	policy, _, err := client.ACLPolicies().Info(policyName, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil
+		}
+		return fmt.Errorf("error reading ACLPolicy %q: %s", policyName, err.Error())
+	}
+
+	if !jobACLMatchesAttachment(policy.JobACL, namespace, jobID, group, task) {
+		// The policy's job_acl was already changed out from under this
+		// attachment (e.g. the inline job_acl on nomad_acl_policy was
+		// repointed elsewhere) - there's nothing this attachment still
+		// owns, so there's nothing to detach.
+		log.Printf("[DEBUG] ACL policy %q job_acl no longer matches this attachment, nothing to detach", policyName)
+		return nil
+	}
+
+	policy.JobACL = nil
+
+	log.Printf("[DEBUG] Detaching workload identity from ACL policy %q", policyName)
+	_, err = client.ACLPolicies().Upsert(policy, nil)
+	if err != nil {
+		return fmt.Errorf("error detaching workload identity from ACLPolicy %q: %s", policyName, err.Error())
+	}
+	log.Printf("[DEBUG] Detached workload identity from ACL policy %q", policyName)
+
+	return nil
+}
+
+func resourceACLPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	providerConfig := meta.(ProviderConfig)
+	client := providerConfig.client
+
+	policyName, namespace, jobID, group, task, err := parseACLPolicyAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading ACL policy %q to verify workload identity attachment", policyName)
+	policy, _, err := client.ACLPolicies().Info(policyName, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] ACL policy %q not found, removing attachment from state", policyName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACLPolicy %q: %s", policyName, err.Error())
+	}
+
+	if !jobACLMatchesAttachment(policy.JobACL, namespace, jobID, group, task) {
+		log.Printf("[WARN] workload identity attachment on ACL policy %q no longer matches, removing from state", policyName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("policy_name", policyName)
+	d.Set("namespace", namespace)
+	d.Set("job_id", jobID)
+	d.Set("group", group)
+	d.Set("task", task)
+
+	return nil
+}
+
+// jobACLMatchesAttachment reports whether a policy's live JobACL is still
+// the one this attachment set.
+func jobACLMatchesAttachment(jobACL *api.JobACL, namespace, jobID, group, task string) bool {
+	return jobACL != nil &&
+		jobACL.Namespace == namespace &&
+		jobACL.JobID == jobID &&
+		jobACL.Group == group &&
+		jobACL.Task == task
+}
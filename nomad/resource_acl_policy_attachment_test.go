@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import "testing"
+
+func TestValidateJobACLHierarchy(t *testing.T) {
+	cases := []struct {
+		name                          string
+		namespace, jobID, group, task string
+		wantErr                       bool
+	}{
+		{"all empty is valid", "", "", "", "", false},
+		{"job only is valid", "default", "web", "", "", false},
+		{"job and group is valid", "default", "web", "api", "", false},
+		{"job, group and task is valid", "default", "web", "api", "server", false},
+		{"job without namespace is invalid", "", "web", "", "", true},
+		{"group without job is invalid", "default", "", "api", "", true},
+		{"task without group is invalid", "default", "web", "", "server", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateJobACLHierarchy(c.namespace, c.jobID, c.group, c.task)
+			if c.wantErr && err == nil {
+				t.Fatalf("expected an error, got none")
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceACLPolicyAttachmentIDRoundTrip(t *testing.T) {
+	cases := []struct {
+		name                                      string
+		policyName, namespace, jobID, group, task string
+	}{
+		{"job only", "my-policy", "default", "web", "", ""},
+		{"job and group", "my-policy", "default", "web", "api", ""},
+		{"job, group and task", "my-policy", "default", "web", "api", "server"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			id := resourceACLPolicyAttachmentID(c.policyName, c.namespace, c.jobID, c.group, c.task)
+
+			policyName, namespace, jobID, group, task, err := parseACLPolicyAttachmentID(id)
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %v", id, err)
+			}
+
+			if policyName != c.policyName || namespace != c.namespace || jobID != c.jobID || group != c.group || task != c.task {
+				t.Fatalf("round trip mismatch: got (%q, %q, %q, %q, %q), want (%q, %q, %q, %q, %q)",
+					policyName, namespace, jobID, group, task,
+					c.policyName, c.namespace, c.jobID, c.group, c.task)
+			}
+		})
+	}
+}
+
+func TestParseACLPolicyAttachmentIDInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"my-policy",
+		"my-policy:default/web/api",
+		":default/web/api/server",
+		"my-policy:default//api/server",
+	}
+
+	for _, id := range cases {
+		t.Run(id, func(t *testing.T) {
+			if _, _, _, _, _, err := parseACLPolicyAttachmentID(id); err == nil {
+				t.Fatalf("expected an error parsing %q, got none", id)
+			}
+		})
+	}
+}
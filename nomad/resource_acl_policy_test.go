@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nomad
+
+import "testing"
+
+func TestValidateACLJobACLIdentifier(t *testing.T) {
+	cases := []struct {
+		name     string
+		required bool
+		value    string
+		wantErr  bool
+	}{
+		{"optional empty is allowed", false, "", false},
+		{"required empty is rejected", true, "", true},
+		{"optional valid value", false, "web", false},
+		{"required valid value", true, "web", false},
+		{"rejects forward slash", false, "group/task", true},
+		{"rejects backslash", false, `group\task`, true},
+		{"rejects too long", false, stringOfLen(129), true},
+		{"allows max length", false, stringOfLen(128), false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, errs := validateACLJobACLIdentifier(c.required)(c.value, "job_id")
+			if c.wantErr && len(errs) == 0 {
+				t.Fatalf("expected an error for %q, got none", c.value)
+			}
+			if !c.wantErr && len(errs) != 0 {
+				t.Fatalf("expected no error for %q, got %v", c.value, errs)
+			}
+		})
+	}
+}
+
+func stringOfLen(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = 'a'
+	}
+	return string(b)
+}